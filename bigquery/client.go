@@ -0,0 +1,87 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigquery
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+	bq "google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/option"
+	"google.golang.org/api/transport"
+)
+
+// Scope is the OAuth2 scope required by the BigQuery API.
+const Scope = "https://www.googleapis.com/auth/bigquery"
+
+const userAgent = "gcloud-golang-bigquery/20160429"
+
+// Client may be used to perform BigQuery operations.
+type Client struct {
+	projectID string
+	bqs       *bq.Service
+	service   service
+
+	// opts holds the ClientOptions NewClient was called with, so that
+	// other clients constructed on this Client's behalf (for example the
+	// GCS client used to write extract schema sidecars) authenticate the
+	// same way.
+	opts []option.ClientOption
+}
+
+// NewClient constructs a new Client which can perform BigQuery operations.
+// Operations performed via the client are billed to the specified GCP
+// project.
+func NewClient(ctx context.Context, projectID string, opts ...option.ClientOption) (*Client, error) {
+	o := []option.ClientOption{
+		option.WithScopes(Scope),
+		option.WithUserAgent(userAgent),
+	}
+	o = append(o, opts...)
+	httpClient, _, err := transport.NewHTTPClient(ctx, o...)
+	if err != nil {
+		return nil, fmt.Errorf("bigquery: constructing client: %v", err)
+	}
+	bqs, err := bq.New(httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("bigquery: constructing client: %v", err)
+	}
+	c := &Client{
+		projectID: projectID,
+		bqs:       bqs,
+		opts:      opts,
+	}
+	c.service = &bigqueryService{s: bqs}
+	return c, nil
+}
+
+// service abstracts the job, table-metadata and dataset-listing BigQuery API
+// calls made by this package, so that they can be faked in tests. Table
+// listing does not go through it; see listTables.
+type service interface {
+	insertJob(ctx context.Context, projectID string, job *bq.Job) (*Job, error)
+	getJob(ctx context.Context, projectID, jobID string) (*bq.Job, error)
+	getTable(ctx context.Context, projectID, datasetID, tableID string) (*bq.Table, error)
+	listDatasets(ctx context.Context, projectID string, pageSize int, pageToken string, listHidden bool, filter string) ([]*Dataset, string, error)
+}
+
+func (c *Client) insertJob(ctx context.Context, job *bq.Job) (*Job, error) {
+	j, err := c.service.insertJob(ctx, c.projectID, job)
+	if err != nil {
+		return nil, err
+	}
+	j.c = c
+	return j, nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigquery
+
+import (
+	"errors"
+	"time"
+
+	"golang.org/x/net/context"
+	bq "google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/iterator"
+)
+
+// A Dataset refers to a BigQuery dataset.
+type Dataset struct {
+	ProjectID string
+	DatasetID string
+
+	c *Client
+}
+
+// Dataset creates a handle to a BigQuery dataset.
+func (c *Client) Dataset(id string) *Dataset {
+	return &Dataset{
+		ProjectID: c.projectID,
+		DatasetID: id,
+		c:         c,
+	}
+}
+
+// DatasetMetadata contains information about a BigQuery dataset.
+type DatasetMetadata struct {
+	// FullID is the fully-qualified ID of the dataset, in the form
+	// projectID:datasetID. It is read-only.
+	FullID string
+
+	// Name is a user-friendly name for the dataset.
+	Name string
+
+	// Description is a user-friendly description of the dataset.
+	Description string
+
+	// DefaultTableExpiration, if non-zero, is the default expiration for
+	// newly created tables in the dataset.
+	DefaultTableExpiration time.Duration
+
+	// Location is the geographic location of the dataset, e.g. "EU".
+	Location string
+
+	// Labels are user-provided key-value pairs, used for filtering and
+	// cost attribution.
+	Labels map[string]string
+}
+
+// bqDatasetFromMetadata translates DatasetMetadata into the write-only
+// fields of a bq.Dataset, for use in dataset creation and update calls.
+func bqDatasetFromMetadata(dm *DatasetMetadata) (*bq.Dataset, error) {
+	if dm == nil {
+		return &bq.Dataset{}, nil
+	}
+	if dm.FullID != "" {
+		return nil, errors.New("bigquery: DatasetMetadata.FullID is not writable")
+	}
+	ds := &bq.Dataset{
+		FriendlyName: dm.Name,
+		Description:  dm.Description,
+		Location:     dm.Location,
+		Labels:       dm.Labels,
+	}
+	if dm.DefaultTableExpiration != 0 {
+		ds.DefaultTableExpirationMs = int64(dm.DefaultTableExpiration / time.Millisecond)
+	}
+	return ds, nil
+}
+
+// DatasetIterator iterates over the datasets in a project.
+type DatasetIterator struct {
+	// ListHidden determines whether hidden datasets are listed.
+	ListHidden bool
+
+	// LabelFilter restricts the listing to datasets whose labels match the
+	// given BigQuery label filter expression, e.g. "labels.env:prod". It is
+	// passed through to the underlying datasets.list call, so results are
+	// filtered server-side rather than fetched and discarded client-side.
+	LabelFilter string
+
+	ctx      context.Context
+	c        *Client
+	pageInfo *iterator.PageInfo
+	nextFunc func() error
+	items    []*Dataset
+}
+
+// Datasets returns an iterator over the datasets in the Client's project.
+func (c *Client) Datasets(ctx context.Context) *DatasetIterator {
+	it := &DatasetIterator{ctx: ctx, c: c}
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(
+		it.fetch,
+		func() int { return len(it.items) },
+		func() interface{} { b := it.items; it.items = nil; return b })
+	return it
+}
+
+// PageInfo supports pagination.
+func (it *DatasetIterator) PageInfo() *iterator.PageInfo { return it.pageInfo }
+
+// Next returns the next result. Its second return value is iterator.Done if
+// there are no more results.
+func (it *DatasetIterator) Next() (*Dataset, error) {
+	if err := it.nextFunc(); err != nil {
+		return nil, err
+	}
+	item := it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+func (it *DatasetIterator) fetch(pageSize int, pageToken string) (string, error) {
+	datasets, nextPageToken, err := it.c.service.listDatasets(
+		it.ctx, it.c.projectID, pageSize, pageToken, it.ListHidden, it.LabelFilter)
+	if err != nil {
+		return "", err
+	}
+	for _, d := range datasets {
+		d.c = it.c
+		it.items = append(it.items, d)
+	}
+	return nextPageToken, nil
+}
@@ -15,10 +15,27 @@
 package bigquery
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"cloud.google.com/go/storage"
 	"golang.org/x/net/context"
 	bq "google.golang.org/api/bigquery/v2"
 )
 
+// AvroOptions are additional options for exporting data in Avro format.
+type AvroOptions struct {
+	// UseAvroLogicalTypes specifies whether BigQuery should use Avro logical
+	// types (e.g. TIMESTAMP, DATE) instead of their raw Avro equivalents
+	// (e.g. long) when exporting semantically-typed columns. It is only
+	// meaningful when the destination format is Avro.
+	UseAvroLogicalTypes bool
+}
+
 // ExtractConfig holds the configuration for an extract job.
 type ExtractConfig struct {
 	// JobID is the ID to use for the job. If empty, a random job ID will be generated.
@@ -35,6 +52,22 @@ type ExtractConfig struct {
 
 	// DisableHeader disables the printing of a header row in exported data.
 	DisableHeader bool
+
+	// AvroOptions configures Avro-specific export behavior. It is only used
+	// when Dst.DestinationFormat is Avro.
+	AvroOptions *AvroOptions
+
+	// WriteSchemaFile, if true, causes RunAndWait to write the source table's
+	// schema as a sidecar JSON file in the same GCS prefix as the exported
+	// data, so that tools which do not speak the BigQuery API (for example
+	// Spark or Hive) can discover the schema without an API round trip. The
+	// sidecar is named after the first destination URI with its extension,
+	// if any, replaced by "_schema.json".
+	WriteSchemaFile bool
+
+	// Labels to associate with the job. These are billed and can be used
+	// for cost attribution, e.g. {"team": "analytics"}.
+	Labels map[string]string
 }
 
 // An Extractor extracts data from a BigQuery table into Google Cloud Storage.
@@ -56,28 +89,142 @@ func (t *Table) ExtractorTo(dst *GCSReference) *Extractor {
 	}
 }
 
-// Run initiates an extract job.
+// Run initiates an extract job. WriteSchemaFile, if set, is ignored: Run
+// does not wait for the job to succeed, so there is nothing yet to write a
+// sidecar for. Use RunAndWait instead if WriteSchemaFile is set.
 func (e *Extractor) Run(ctx context.Context) (*Job, error) {
-	return e.c.insertJob(ctx, e.newJob(), nil)
+	job, err := e.newJob()
+	if err != nil {
+		return nil, err
+	}
+	return e.c.insertJob(ctx, job)
 }
 
-func (e *Extractor) newJob() *bq.Job {
+// RunAndWait is like Run, but blocks until the extract job is done, calling
+// opts.Progress, if set, with each JobStatus snapshot as it is polled, and
+// returns the final JobStatus. A job that completes with an error is
+// reported via JobStatus.Err, not as the returned error. If WriteSchemaFile
+// is set, the schema sidecar is written only once the job has finished
+// successfully, so a failed or still-running extract never leaves a
+// sidecar with no corresponding data behind.
+func (e *Extractor) RunAndWait(ctx context.Context, opts RunAndWaitOptions) (*JobStatus, error) {
+	job, err := e.Run(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status, err := waitForJob(ctx, job, opts)
+	if err != nil {
+		return nil, err
+	}
+	if e.WriteSchemaFile && status.State == Done && status.Err == nil {
+		if err := e.writeSchemaFile(ctx); err != nil {
+			return status, err
+		}
+	}
+	return status, nil
+}
+
+func (e *Extractor) newJob() (*bq.Job, error) {
+	if err := validateExtractCompression(e.Dst.DestinationFormat, e.Dst.Compression); err != nil {
+		return nil, err
+	}
 	var printHeader *bool
 	if e.DisableHeader {
 		f := false
 		printHeader = &f
 	}
+	var useAvroLogicalTypes bool
+	if e.AvroOptions != nil {
+		useAvroLogicalTypes = e.AvroOptions.UseAvroLogicalTypes
+	}
 	return &bq.Job{
 		JobReference: createJobRef(e.JobID, e.AddJobIDSuffix, e.c.projectID),
 		Configuration: &bq.JobConfiguration{
+			Labels: e.Labels,
 			Extract: &bq.JobConfigurationExtract{
-				DestinationUris:   append([]string{}, e.Dst.uris...),
-				Compression:       string(e.Dst.Compression),
-				DestinationFormat: string(e.Dst.DestinationFormat),
-				FieldDelimiter:    e.Dst.FieldDelimiter,
-				SourceTable:       e.Src.tableRefProto(),
-				PrintHeader:       printHeader,
+				DestinationUris:     append([]string{}, e.Dst.uris...),
+				Compression:         string(e.Dst.Compression),
+				DestinationFormat:   string(e.Dst.DestinationFormat),
+				FieldDelimiter:      e.Dst.FieldDelimiter,
+				SourceTable:         e.Src.tableRefProto(),
+				PrintHeader:         printHeader,
+				UseAvroLogicalTypes: useAvroLogicalTypes,
 			},
 		},
+	}, nil
+}
+
+// validateExtractCompression reports an error if compression is not one of
+// the codecs BigQuery supports for the given destination format. An empty
+// format is treated as CSV, BigQuery's default.
+func validateExtractCompression(format DestinationFormat, compression Compression) error {
+	if compression == "" || compression == None {
+		return nil
+	}
+	var allowed []Compression
+	switch format {
+	case "", CSV, JSON:
+		allowed = []Compression{Gzip}
+	case Avro:
+		allowed = []Compression{Snappy, Deflate}
+	case Parquet:
+		allowed = []Compression{Snappy, Gzip}
+	default:
+		return fmt.Errorf("bigquery: unknown destination format %q", format)
+	}
+	for _, a := range allowed {
+		if compression == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("bigquery: compression %q is not supported for destination format %q", compression, format)
+}
+
+// writeSchemaFile uploads the source table's schema as a JSON sidecar
+// object next to the exported data, so that tools which do not speak the
+// BigQuery API can read it without an API round trip.
+func (e *Extractor) writeSchemaFile(ctx context.Context) error {
+	if len(e.Dst.uris) == 0 {
+		return errors.New("bigquery: WriteSchemaFile requires Dst to have at least one destination URI")
+	}
+	md, err := e.Src.Metadata(ctx)
+	if err != nil {
+		return fmt.Errorf("bigquery: reading schema for sidecar file: %v", err)
+	}
+	data, err := json.MarshalIndent(md.Schema, "", "  ")
+	if err != nil {
+		return fmt.Errorf("bigquery: marshaling schema for sidecar file: %v", err)
+	}
+	bucket, object, err := schemaSidecarObject(e.Dst.uris[0])
+	if err != nil {
+		return err
+	}
+	client, err := storage.NewClient(ctx, e.c.opts...)
+	if err != nil {
+		return fmt.Errorf("bigquery: creating GCS client for schema sidecar file: %v", err)
+	}
+	defer client.Close()
+	w := client.Bucket(bucket).Object(object).NewWriter(ctx)
+	w.ContentType = "application/json"
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return fmt.Errorf("bigquery: writing schema sidecar file: %v", err)
+	}
+	return w.Close()
+}
+
+// schemaSidecarObject derives the GCS bucket and object name for a schema
+// sidecar file from a gs:// destination URI, replacing the URI's extension,
+// if any, with "_schema.json".
+func schemaSidecarObject(uri string) (bucket, object string, err error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", "", fmt.Errorf("bigquery: parsing destination URI %q: %v", uri, err)
+	}
+	if u.Scheme != "gs" {
+		return "", "", fmt.Errorf("bigquery: destination URI %q is not a gs:// URI", uri)
 	}
+	object = strings.TrimPrefix(u.Path, "/")
+	object = strings.TrimSuffix(object, path.Ext(object))
+	return u.Host, object + "_schema.json", nil
 }
@@ -0,0 +1,107 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigquery
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestValidateExtractCompression(t *testing.T) {
+	for _, test := range []struct {
+		format      DestinationFormat
+		compression Compression
+		wantErr     bool
+	}{
+		{CSV, None, false},
+		{CSV, Gzip, false},
+		{CSV, Snappy, true},
+		{"", None, false},
+		{JSON, Gzip, false},
+		{JSON, Deflate, true},
+		{Avro, Snappy, false},
+		{Avro, Deflate, false},
+		{Avro, Gzip, true},
+		{Parquet, Snappy, false},
+		{Parquet, Gzip, false},
+		{Parquet, Deflate, true},
+	} {
+		err := validateExtractCompression(test.format, test.compression)
+		if (err != nil) != test.wantErr {
+			t.Errorf("format=%q compression=%q: got err %v, wantErr %t", test.format, test.compression, err, test.wantErr)
+		}
+	}
+}
+
+func TestSchemaSidecarObject(t *testing.T) {
+	for _, test := range []struct {
+		uri        string
+		wantBucket string
+		wantObject string
+		wantErr    bool
+	}{
+		{"gs://my-bucket/path/to/data.csv", "my-bucket", "path/to/data_schema.json", false},
+		{"gs://my-bucket/data", "my-bucket", "data_schema.json", false},
+		{"https://example.com/data.csv", "", "", true},
+		{":not a uri", "", "", true},
+	} {
+		bucket, object, err := schemaSidecarObject(test.uri)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%q: got nil error, want error", test.uri)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: got error %v, want nil", test.uri, err)
+			continue
+		}
+		if bucket != test.wantBucket || object != test.wantObject {
+			t.Errorf("%q: got (%q, %q), want (%q, %q)", test.uri, bucket, object, test.wantBucket, test.wantObject)
+		}
+	}
+}
+
+func TestWriteSchemaFileRequiresDestinationURI(t *testing.T) {
+	e := &Extractor{
+		c: &Client{},
+		ExtractConfig: ExtractConfig{
+			Src: &Table{},
+			Dst: NewGCSReference(),
+		},
+	}
+	if err := e.writeSchemaFile(context.Background()); err == nil {
+		t.Error("got nil error, want error for a destination with no URIs")
+	}
+}
+
+func TestExtractorNewJobLabels(t *testing.T) {
+	e := &Extractor{
+		c: &Client{projectID: "p"},
+		ExtractConfig: ExtractConfig{
+			Src:    &Table{ProjectID: "p", DatasetID: "d", TableID: "t"},
+			Dst:    NewGCSReference("gs://bucket/data.csv"),
+			Labels: map[string]string{"team": "analytics"},
+		},
+	}
+	job, err := e.newJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := job.Configuration.Labels["team"], "analytics"; got != want {
+		t.Errorf("Labels[team]: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2016 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigquery
+
+// DestinationFormat is the format to use for exported data.
+type DestinationFormat string
+
+const (
+	// CSV is a comma-separated variable format suitable for CSV files.
+	CSV DestinationFormat = "CSV"
+
+	// Avro is an Apache Avro binary format.
+	Avro DestinationFormat = "AVRO"
+
+	// JSON is a newline-delimited JSON format.
+	JSON DestinationFormat = "NEWLINE_DELIMITED_JSON"
+
+	// Parquet is an Apache Parquet columnar binary format.
+	Parquet DestinationFormat = "PARQUET"
+)
+
+// Compression is the type of compression to apply when exporting data
+// to Google Cloud Storage.
+type Compression string
+
+const (
+	// None specifies no compression.
+	None Compression = "NONE"
+
+	// Gzip specifies gzip compression.
+	Gzip Compression = "GZIP"
+
+	// Deflate specifies DEFLATE compression.
+	Deflate Compression = "DEFLATE"
+
+	// Snappy specifies Snappy compression.
+	Snappy Compression = "SNAPPY"
+)
+
+// GCSReference is a reference to one or more Google Cloud Storage objects,
+// which together form a data source or destination for a BigQuery operation.
+type GCSReference struct {
+	uris []string
+
+	// FieldDelimiter is the separator for fields in a CSV file, used when
+	// reading or exporting data. The default is a comma (,).
+	FieldDelimiter string
+
+	// DestinationFormat is the format to use when writing exported data to
+	// this reference. The default is CSV.
+	DestinationFormat DestinationFormat
+
+	// Compression specifies the compression codec to use when writing
+	// exported data to this reference. The default is None.
+	Compression Compression
+
+	// ModelFormat is the artifact format to use when this reference is the
+	// destination of a BigQuery ML model extract, via Model.ExtractorTo. It
+	// is ignored for table extracts.
+	ModelFormat ModelFormat
+}
+
+// NewGCSReference constructs a reference to one or more Google Cloud Storage
+// objects, which together form a data source or destination.
+// In the simple case, a single URI in the form gs://bucket/object may refer
+// to a single GCS object. Data may also be split into multiple files, if
+// multiple URIs or a URI containing a wildcard is provided.
+func NewGCSReference(uri ...string) *GCSReference {
+	return &GCSReference{uris: uri}
+}
@@ -0,0 +1,185 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigquery
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+	bq "google.golang.org/api/bigquery/v2"
+)
+
+// A Job represents an operation which has been submitted to BigQuery for
+// processing.
+type Job struct {
+	c         *Client
+	projectID string
+	jobID     string
+}
+
+// JobState is the state of a BigQuery job.
+type JobState string
+
+const (
+	// Pending is a state that describes that the job is pending.
+	Pending JobState = "PENDING"
+
+	// Running is a state that describes that the job is running.
+	Running JobState = "RUNNING"
+
+	// Done is a state that describes that the job is done. This state
+	// doesn't mean the job completed successfully; use JobStatus.Err to
+	// find out.
+	Done JobState = "DONE"
+)
+
+// JobStatus contains the current state of a job, and the error, if any,
+// that caused it to fail.
+type JobStatus struct {
+	// State is the current state of the job.
+	State JobState
+
+	// TotalBytesProcessed is the number of bytes processed by the job so
+	// far, for job types that report this statistic. It is zero otherwise.
+	TotalBytesProcessed int64
+
+	// Err is non-nil if the job has finished and failed.
+	Err error
+
+	// Errors contains every error BigQuery reported for the job, which may
+	// include more than one entry for jobs with partial failures. Err is
+	// taken from the job's final error result, separately from this list,
+	// and is not necessarily one of its elements.
+	Errors []error
+}
+
+// Error contains detailed information about a failed job. It preserves the
+// reason, location and message that BigQuery reports, so that callers can
+// distinguish, for example, a schema mismatch from a permissions error.
+type Error struct {
+	// Reason is a short error code, e.g. "invalid", "notFound" or
+	// "accessDenied", that categorizes the error.
+	Reason string
+
+	// Location is the field or parameter that the error is associated
+	// with, if any.
+	Location string
+
+	// Message is a human-readable description of the error.
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Location != "" {
+		return fmt.Sprintf("bigquery: %s (reason: %s, location: %s)", e.Message, e.Reason, e.Location)
+	}
+	return fmt.Sprintf("bigquery: %s (reason: %s)", e.Message, e.Reason)
+}
+
+func errorFromErrorProto(ep *bq.ErrorProto) error {
+	if ep == nil {
+		return nil
+	}
+	return &Error{Reason: ep.Reason, Location: ep.Location, Message: ep.Message}
+}
+
+// Status retrieves the current status of the job from BigQuery.
+func (j *Job) Status(ctx context.Context) (*JobStatus, error) {
+	bqJob, err := j.c.service.getJob(ctx, j.projectID, j.jobID)
+	if err != nil {
+		return nil, err
+	}
+	return jobStatusFromProto(bqJob.Status, bqJob.Statistics), nil
+}
+
+func jobStatusFromProto(s *bq.JobStatus, stats *bq.JobStatistics) *JobStatus {
+	status := &JobStatus{}
+	if s != nil {
+		status.State = JobState(s.State)
+		status.Err = errorFromErrorProto(s.ErrorResult)
+		for _, ep := range s.Errors {
+			status.Errors = append(status.Errors, errorFromErrorProto(ep))
+		}
+	}
+	if stats != nil {
+		status.TotalBytesProcessed = stats.TotalBytesProcessed
+	}
+	return status
+}
+
+// ProgressFunc is called with the latest JobStatus while a RunAndWait
+// method polls for job completion.
+type ProgressFunc func(*JobStatus)
+
+// RunAndWaitOptions configures the polling behavior of a RunAndWait method.
+type RunAndWaitOptions struct {
+	// PollInterval is the delay before the first status check, and the
+	// starting point for the exponential backoff applied between
+	// subsequent checks. If zero, a default of one second is used.
+	PollInterval time.Duration
+
+	// MaxPollInterval caps the backoff applied to PollInterval between
+	// checks. If zero, a default of 30 seconds is used.
+	MaxPollInterval time.Duration
+
+	// Progress, if non-nil, is called with a JobStatus snapshot every time
+	// the job's status is polled, including the final one.
+	Progress ProgressFunc
+}
+
+const (
+	defaultPollInterval    = 1 * time.Second
+	defaultMaxPollInterval = 30 * time.Second
+)
+
+// waitForJob waits opts.PollInterval, then polls job for its status,
+// backing off exponentially between subsequent polls up to
+// opts.MaxPollInterval, until the job is Done or ctx is cancelled. It
+// reports every snapshot it observes via opts.Progress, if set. A job that
+// finishes with an error is reported through the returned JobStatus's Err
+// field, not through the error return value, which is reserved for
+// failures to retrieve status at all.
+func waitForJob(ctx context.Context, job *Job, opts RunAndWaitOptions) (*JobStatus, error) {
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	maxInterval := opts.MaxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultMaxPollInterval
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+		status, err := job.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Progress != nil {
+			opts.Progress(status)
+		}
+		if status.State == Done {
+			return status, nil
+		}
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigquery
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	bq "google.golang.org/api/bigquery/v2"
+)
+
+// fakeJobService services job status requests from an in-memory sequence of
+// *bq.Job snapshots, holding on the last one once exhausted.
+type fakeJobService struct {
+	service
+
+	jobs []*bq.Job
+	next int
+}
+
+func (f *fakeJobService) getJob(ctx context.Context, projectID, jobID string) (*bq.Job, error) {
+	j := f.jobs[f.next]
+	if f.next < len(f.jobs)-1 {
+		f.next++
+	}
+	return j, nil
+}
+
+func TestWaitForJob(t *testing.T) {
+	fake := &fakeJobService{jobs: []*bq.Job{
+		{Status: &bq.JobStatus{State: "RUNNING"}},
+		{Status: &bq.JobStatus{State: "RUNNING"}},
+		{Status: &bq.JobStatus{State: "DONE"}},
+	}}
+	job := &Job{c: &Client{service: fake}, projectID: "p", jobID: "j"}
+
+	var snapshots []JobState
+	opts := RunAndWaitOptions{
+		PollInterval: time.Millisecond,
+		Progress:     func(s *JobStatus) { snapshots = append(snapshots, s.State) },
+	}
+	status, err := waitForJob(context.Background(), job, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.State != Done {
+		t.Errorf("got state %v, want Done", status.State)
+	}
+	want := []JobState{Running, Running, Done}
+	if len(snapshots) != len(want) {
+		t.Fatalf("got %d snapshots %v, want %d", len(snapshots), snapshots, len(want))
+	}
+	for i, s := range snapshots {
+		if s != want[i] {
+			t.Errorf("snapshot %d: got %v, want %v", i, s, want[i])
+		}
+	}
+}
+
+func TestWaitForJobContextCancelled(t *testing.T) {
+	fake := &fakeJobService{jobs: []*bq.Job{
+		{Status: &bq.JobStatus{State: "RUNNING"}},
+	}}
+	job := &Job{c: &Client{service: fake}, projectID: "p", jobID: "j"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := waitForJob(ctx, job, RunAndWaitOptions{PollInterval: time.Millisecond}); err == nil {
+		t.Error("got nil error, want context.Canceled")
+	}
+}
+
+func TestJobStatusFromProtoErrors(t *testing.T) {
+	s := &bq.JobStatus{
+		ErrorResult: &bq.ErrorProto{Reason: "invalid", Message: "bad schema"},
+		Errors: []*bq.ErrorProto{
+			{Reason: "invalid", Message: "bad schema"},
+			{Reason: "notFound", Message: "missing table", Location: "sourceTable"},
+		},
+	}
+	status := jobStatusFromProto(s, nil)
+	if status.Err == nil {
+		t.Fatal("got nil Err, want non-nil")
+	}
+	if len(status.Errors) != 2 {
+		t.Fatalf("got %d errors, want 2", len(status.Errors))
+	}
+	want := &Error{Reason: "notFound", Location: "sourceTable", Message: "missing table"}
+	if got := status.Errors[1].(*Error); *got != *want {
+		t.Errorf("Errors[1]: got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigquery
+
+import (
+	"golang.org/x/net/context"
+	bq "google.golang.org/api/bigquery/v2"
+)
+
+// ModelFormat is the artifact format to use when extracting a BigQuery ML
+// model to Google Cloud Storage.
+type ModelFormat string
+
+const (
+	// TFSavedModel extracts the model as a TensorFlow SavedModel.
+	TFSavedModel ModelFormat = "ML_TF_SAVED_MODEL"
+
+	// XGBoostBooster extracts the model as an XGBoost Booster file.
+	XGBoostBooster ModelFormat = "ML_XGBOOST_BOOSTER"
+)
+
+// A Model refers to a BigQuery ML model.
+type Model struct {
+	ProjectID string
+	DatasetID string
+	ModelID   string
+
+	c *Client
+}
+
+// Model creates a handle to a BigQuery ML model in the dataset.
+func (d *Dataset) Model(id string) *Model {
+	return &Model{
+		ProjectID: d.ProjectID,
+		DatasetID: d.DatasetID,
+		ModelID:   id,
+		c:         d.c,
+	}
+}
+
+func (m *Model) modelRefProto() *bq.ModelReference {
+	return &bq.ModelReference{
+		ProjectId: m.ProjectID,
+		DatasetId: m.DatasetID,
+		ModelId:   m.ModelID,
+	}
+}
+
+// ModelExtractConfig holds the configuration for a model extract job.
+type ModelExtractConfig struct {
+	// JobID is the ID to use for the job. If empty, a random job ID will be generated.
+	JobID string
+
+	// If AddJobIDSuffix is true, then a random string will be appended to JobID.
+	AddJobIDSuffix bool
+
+	// Src is the model whose trained artifacts will be extracted.
+	Src *Model
+
+	// Dst is the destination into which the model will be extracted. Its
+	// ModelFormat determines whether a TensorFlow SavedModel or an XGBoost
+	// Booster is produced.
+	Dst *GCSReference
+
+	// Labels to associate with the job. These are billed and can be used
+	// for cost attribution, e.g. {"team": "analytics"}.
+	Labels map[string]string
+}
+
+// A ModelExtractor extracts a BigQuery ML model into Google Cloud Storage.
+type ModelExtractor struct {
+	ModelExtractConfig
+	c *Client
+}
+
+// ExtractorTo returns a ModelExtractor which can be used to extract a
+// BigQuery ML model into Google Cloud Storage, in the format given by
+// dst.ModelFormat.
+// The returned ModelExtractor may optionally be further configured before
+// its Run method is called.
+func (m *Model) ExtractorTo(dst *GCSReference) *ModelExtractor {
+	return &ModelExtractor{
+		c: m.c,
+		ModelExtractConfig: ModelExtractConfig{
+			Src: m,
+			Dst: dst,
+		},
+	}
+}
+
+// Run initiates a model extract job.
+func (e *ModelExtractor) Run(ctx context.Context) (*Job, error) {
+	return e.c.insertJob(ctx, e.newJob())
+}
+
+func (e *ModelExtractor) newJob() *bq.Job {
+	return &bq.Job{
+		JobReference: createJobRef(e.JobID, e.AddJobIDSuffix, e.c.projectID),
+		Configuration: &bq.JobConfiguration{
+			Labels: e.Labels,
+			Extract: &bq.JobConfigurationExtract{
+				DestinationUris:   append([]string{}, e.Dst.uris...),
+				DestinationFormat: string(e.Dst.ModelFormat),
+				SourceModel:       e.Src.modelRefProto(),
+			},
+		},
+	}
+}
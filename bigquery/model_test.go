@@ -0,0 +1,51 @@
+// Copyright 2017 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigquery
+
+import (
+	"testing"
+
+	bq "google.golang.org/api/bigquery/v2"
+)
+
+func TestDatasetModel(t *testing.T) {
+	c := &Client{projectID: "p"}
+	m := c.Dataset("d").Model("m")
+	want := &Model{ProjectID: "p", DatasetID: "d", ModelID: "m", c: c}
+	if *m != *want {
+		t.Errorf("got %+v, want %+v", m, want)
+	}
+}
+
+func TestModelExtractorNewJob(t *testing.T) {
+	c := &Client{projectID: "p"}
+	m := c.Dataset("d").Model("m")
+	dst := NewGCSReference("gs://bucket/model")
+	dst.ModelFormat = TFSavedModel
+	e := m.ExtractorTo(dst)
+	e.Labels = map[string]string{"team": "analytics"}
+
+	job := e.newJob()
+	extract := job.Configuration.Extract
+	if got, want := extract.DestinationFormat, string(TFSavedModel); got != want {
+		t.Errorf("DestinationFormat: got %q, want %q", got, want)
+	}
+	if got, want := extract.SourceModel, (&bq.ModelReference{ProjectId: "p", DatasetId: "d", ModelId: "m"}); *got != *want {
+		t.Errorf("SourceModel: got %+v, want %+v", got, want)
+	}
+	if got, want := job.Configuration.Labels["team"], "analytics"; got != want {
+		t.Errorf("Labels[team]: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigquery
+
+import (
+	"golang.org/x/net/context"
+	bq "google.golang.org/api/bigquery/v2"
+)
+
+// bigqueryService implements the service interface against the real
+// BigQuery API.
+type bigqueryService struct {
+	s *bq.Service
+}
+
+func (s *bigqueryService) insertJob(ctx context.Context, projectID string, job *bq.Job) (*Job, error) {
+	res, err := s.s.Jobs.Insert(projectID, job).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return &Job{
+		projectID: res.JobReference.ProjectId,
+		jobID:     res.JobReference.JobId,
+	}, nil
+}
+
+func (s *bigqueryService) getJob(ctx context.Context, projectID, jobID string) (*bq.Job, error) {
+	return s.s.Jobs.Get(projectID, jobID).Context(ctx).Do()
+}
+
+func (s *bigqueryService) getTable(ctx context.Context, projectID, datasetID, tableID string) (*bq.Table, error) {
+	return s.s.Tables.Get(projectID, datasetID, tableID).Context(ctx).Do()
+}
+
+func (s *bigqueryService) listDatasets(ctx context.Context, projectID string, pageSize int, pageToken string, listHidden bool, filter string) ([]*Dataset, string, error) {
+	call := s.s.Datasets.List(projectID).
+		Context(ctx).
+		All(listHidden).
+		PageToken(pageToken)
+	if pageSize > 0 {
+		call = call.MaxResults(int64(pageSize))
+	}
+	if filter != "" {
+		call = call.Filter(filter)
+	}
+	res, err := call.Do()
+	if err != nil {
+		return nil, "", err
+	}
+	var datasets []*Dataset
+	for _, d := range res.Datasets {
+		datasets = append(datasets, &Dataset{
+			ProjectID: d.DatasetReference.ProjectId,
+			DatasetID: d.DatasetReference.DatasetId,
+		})
+	}
+	return datasets, res.NextPageToken, nil
+}
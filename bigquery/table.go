@@ -0,0 +1,160 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigquery
+
+import (
+	"strings"
+
+	"golang.org/x/net/context"
+	bq "google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/iterator"
+)
+
+// A Table refers to a BigQuery table.
+type Table struct {
+	ProjectID string
+	DatasetID string
+	TableID   string
+
+	c *Client
+}
+
+// Table creates a handle to a BigQuery table in the dataset.
+func (d *Dataset) Table(id string) *Table {
+	return &Table{
+		ProjectID: d.ProjectID,
+		DatasetID: d.DatasetID,
+		TableID:   id,
+		c:         d.c,
+	}
+}
+
+func (t *Table) tableRefProto() *bq.TableReference {
+	return &bq.TableReference{
+		ProjectId: t.ProjectID,
+		DatasetId: t.DatasetID,
+		TableId:   t.TableID,
+	}
+}
+
+// TableMetadata contains information about a BigQuery table.
+type TableMetadata struct {
+	// Schema is the table's schema, as reported by BigQuery.
+	Schema *bq.TableSchema
+}
+
+// Metadata fetches the metadata for the table.
+func (t *Table) Metadata(ctx context.Context) (*TableMetadata, error) {
+	bqTable, err := t.c.service.getTable(ctx, t.ProjectID, t.DatasetID, t.TableID)
+	if err != nil {
+		return nil, err
+	}
+	return &TableMetadata{Schema: bqTable.Schema}, nil
+}
+
+// TableIterator iterates over the tables in a dataset.
+type TableIterator struct {
+	// LabelFilter restricts the listing to tables whose labels match the
+	// given BigQuery label filter expression, e.g. "labels.env:prod" or
+	// "labels.env" for a key with any value. Unlike DatasetIterator's
+	// LabelFilter, this is applied client-side over each fetched page:
+	// the tables.list REST method, unlike datasets.list, has no
+	// server-side label filter.
+	LabelFilter string
+
+	ctx      context.Context
+	dataset  *Dataset
+	pageInfo *iterator.PageInfo
+	nextFunc func() error
+	items    []*Table
+}
+
+// Tables returns an iterator over the tables in the Dataset.
+func (d *Dataset) Tables(ctx context.Context) *TableIterator {
+	it := &TableIterator{ctx: ctx, dataset: d}
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(
+		it.fetch,
+		func() int { return len(it.items) },
+		func() interface{} { b := it.items; it.items = nil; return b })
+	return it
+}
+
+// PageInfo supports pagination.
+func (it *TableIterator) PageInfo() *iterator.PageInfo { return it.pageInfo }
+
+// Next returns the next result. Its second return value is iterator.Done if
+// there are no more results.
+func (it *TableIterator) Next() (*Table, error) {
+	if err := it.nextFunc(); err != nil {
+		return nil, err
+	}
+	item := it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+func (it *TableIterator) fetch(pageSize int, pageToken string) (string, error) {
+	list, err := listTables(it, pageSize, pageToken)
+	if err != nil {
+		return "", err
+	}
+	for _, t := range list.Tables {
+		if it.LabelFilter != "" && !matchesLabelFilter(t.Labels, it.LabelFilter) {
+			continue
+		}
+		it.items = append(it.items, bqToTable(t.TableReference, it.dataset.c))
+	}
+	return list.NextPageToken, nil
+}
+
+// matchesLabelFilter reports whether labels satisfies filter, a BigQuery
+// label filter expression of the form "labels.<key>:<value>" (key with a
+// specific value) or "labels.<key>" (key present, any value).
+func matchesLabelFilter(labels map[string]string, filter string) bool {
+	const prefix = "labels."
+	if !strings.HasPrefix(filter, prefix) {
+		return false
+	}
+	kv := strings.SplitN(filter[len(prefix):], ":", 2)
+	v, ok := labels[kv[0]]
+	if !ok {
+		return false
+	}
+	if len(kv) == 1 {
+		return true
+	}
+	return v == kv[1]
+}
+
+// listTables fetches a page of tables from the BigQuery API. It is a
+// package-level variable so that tests can stub it out.
+var listTables = func(it *TableIterator, pageSize int, pageToken string) (*bq.TableList, error) {
+	call := it.dataset.c.bqs.Tables.List(it.dataset.ProjectID, it.dataset.DatasetID).
+		Context(it.ctx).
+		PageToken(pageToken)
+	if pageSize > 0 {
+		call = call.MaxResults(int64(pageSize))
+	}
+	return call.Do()
+}
+
+func bqToTable(tr *bq.TableReference, c *Client) *Table {
+	return &Table{
+		ProjectID: tr.ProjectId,
+		DatasetID: tr.DatasetId,
+		TableID:   tr.TableId,
+		c:         c,
+	}
+}